@@ -12,14 +12,9 @@ import (
 	"math/rand"
 	"strings"
 	"time"
-	"unsafe"
-)
 
-/*
-#cgo LDFLAGS: -L. -ladler32_neon
-#include "adler32_neon.h"
-*/
-import "C"
+	"github.com/jasonrandrews/adler32-go/adler32neon"
+)
 
 // generateRandomString creates a random string of specified length.
 //
@@ -40,10 +35,8 @@ func generateRandomString(length int) []byte {
 	return b
 }
 
-// calculateNeonAdler32 calculates Adler32 using the C NEON implementation.
-//
-// This function leverages Arm NEON SIMD instructions for improved performance.
-// It handles empty data correctly, returning 1 as per the Adler-32 specification.
+// calculateNeonAdler32 calculates Adler32 using the NEON-accelerated
+// adler32neon package.
 //
 // Parameters:
 //   - data: The byte slice to calculate the checksum for
@@ -51,16 +44,9 @@ func generateRandomString(length int) []byte {
 // Returns:
 //   - The calculated Adler-32 checksum as a uint32
 func calculateNeonAdler32(data []byte) uint32 {
-	if len(data) == 0 {
-		return 1 // Adler-32 of empty data is 1
-	}
-	
-	// Convert Go byte slice to C pointer and length
-	cData := (*C.uint8_t)(unsafe.Pointer(&data[0]))
-	cLen := C.size_t(len(data))
-	
-	// Call the C function
-	return uint32(C.adler32_neon(cData, cLen))
+	h := adler32neon.New()
+	h.Write(data)
+	return h.Sum32()
 }
 
 // benchmarkGoAdler32 runs the Go implementation multiple times and returns average duration.
@@ -0,0 +1,47 @@
+// Package adler32neon implements the Adler-32 checksum defined in RFC
+// 1950 behind a hash.Hash32 interface that mirrors the standard
+// library's hash/adler32 package.
+//
+// On arm64 with cgo enabled and Arm NEON (ASIMD) instructions available
+// at runtime, New uses a NEON-accelerated C kernel. Everywhere else -
+// other architectures, cgo disabled, or NEON simply absent - it falls
+// back to the pure Go hash/adler32 implementation. Either way, New
+// returns a hash.Hash32 that is a drop-in replacement for hash/adler32.
+package adler32neon
+
+import (
+	"hash"
+	"hash/adler32"
+)
+
+// mod is the largest prime smaller than 65536, as specified by RFC 1950.
+const mod = 65521
+
+// Size is the size, in bytes, of an Adler-32 checksum.
+const Size = 4
+
+// backend selects the hash.Hash32 constructor used by New. It defaults
+// to the pure Go implementation; an arm64-and-cgo build's init may
+// override it after confirming NEON support at runtime.
+var backend = newGeneric
+
+// newGeneric returns the pure Go hash/adler32 implementation, used on
+// platforms without a NEON backend and as the runtime fallback when
+// NEON support can't be confirmed.
+func newGeneric() hash.Hash32 {
+	return adler32.New()
+}
+
+// New returns a new hash.Hash32 computing the Adler-32 checksum, using
+// whichever backend is appropriate for the current platform.
+func New() hash.Hash32 {
+	return backend()
+}
+
+// Checksum returns the Adler-32 checksum of data, using whichever
+// backend is appropriate for the current platform.
+func Checksum(data []byte) uint32 {
+	h := New()
+	h.Write(data)
+	return h.Sum32()
+}
@@ -0,0 +1,67 @@
+package adler32neon
+
+import "sync"
+
+// Combine merges the Adler-32 checksums of two adjacent byte ranges,
+// given only the checksums themselves and the length of the second
+// range, without re-reading either range. It matches zlib's
+// adler32_combine and lets large inputs be checksummed in independent
+// chunks and folded back together afterwards.
+func Combine(adler1, adler2 uint32, len2 int64) uint32 {
+	s1a, s2a := adler1&0xffff, adler1>>16
+	s1b, s2b := adler2&0xffff, adler2>>16
+
+	rem := uint32(len2 % mod)
+
+	s1 := (s1a + s1b + mod - 1) % mod
+	s2 := (rem*s1a + mod - rem + s2a + s2b) % mod
+
+	return s2<<16 | s1
+}
+
+// ParallelAdler32 computes the Adler-32 checksum of data by splitting it
+// into roughly equal chunks, checksumming each chunk concurrently across
+// workers goroutines, and folding the per-chunk results back together
+// with Combine. It is intended for multi-megabyte inputs, where a single
+// NEON kernel invocation is no longer the bottleneck.
+func ParallelAdler32(data []byte, workers int) uint32 {
+	if workers < 1 {
+		workers = 1
+	}
+	if len(data) == 0 {
+		return 1
+	}
+
+	chunkSize := (len(data) + workers - 1) / workers
+	if chunkSize == 0 {
+		chunkSize = len(data)
+	}
+
+	var chunks [][]byte
+	for start := 0; start < len(data); start += chunkSize {
+		end := start + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunks = append(chunks, data[start:end])
+	}
+
+	sums := make([]uint32, len(chunks))
+	var wg sync.WaitGroup
+	wg.Add(len(chunks))
+	for i, chunk := range chunks {
+		go func(i int, chunk []byte) {
+			defer wg.Done()
+			h := New()
+			h.Write(chunk)
+			sums[i] = h.Sum32()
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	result := sums[0]
+	for i := 1; i < len(sums); i++ {
+		result = Combine(result, sums[i], int64(len(chunks[i])))
+	}
+	return result
+}
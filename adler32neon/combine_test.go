@@ -0,0 +1,46 @@
+package adler32neon
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCombine(t *testing.T) {
+	data := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog"), 200)
+
+	for _, split := range []int{0, 1, len(data) / 3, len(data) - 1, len(data)} {
+		part1, part2 := data[:split], data[split:]
+
+		h1 := New()
+		h1.Write(part1)
+		h2 := New()
+		h2.Write(part2)
+
+		got := Combine(h1.Sum32(), h2.Sum32(), int64(len(part2)))
+
+		want := New()
+		want.Write(data)
+		if got != want.Sum32() {
+			t.Errorf("split=%d: Combine=%d, want %d", split, got, want.Sum32())
+		}
+	}
+}
+
+func TestParallelAdler32(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 10000)
+
+	want := New()
+	want.Write(data)
+
+	for _, workers := range []int{1, 2, 3, 7, 16} {
+		if got := ParallelAdler32(data, workers); got != want.Sum32() {
+			t.Errorf("workers=%d: ParallelAdler32=%d, want %d", workers, got, want.Sum32())
+		}
+	}
+}
+
+func TestParallelAdler32Empty(t *testing.T) {
+	if got := ParallelAdler32(nil, 4); got != 1 {
+		t.Errorf("ParallelAdler32(nil)=%d, want 1", got)
+	}
+}
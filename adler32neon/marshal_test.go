@@ -0,0 +1,68 @@
+package adler32neon
+
+import (
+	"encoding"
+	"hash/adler32"
+	"testing"
+)
+
+// TestMarshalBinaryInterop checks that a digest's marshaled state can be
+// unmarshaled by the standard library's hash/adler32 implementation, and
+// vice versa, so New's digest is a drop-in replacement for
+// checkpointed pipelines (e.g. resumable zlib/PNG decoders).
+func TestMarshalBinaryInterop(t *testing.T) {
+	const part1 = "The quick brown fox jumps over "
+	const part2 = "the lazy dog"
+
+	t.Run("adler32neon to stdlib", func(t *testing.T) {
+		d := New()
+		d.Write([]byte(part1))
+
+		state, err := d.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary: %v", err)
+		}
+
+		std := adler32.New()
+		if err := std.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+			t.Fatalf("UnmarshalBinary: %v", err)
+		}
+
+		d.Write([]byte(part2))
+		std.Write([]byte(part2))
+
+		if got, want := std.Sum32(), d.Sum32(); got != want {
+			t.Fatalf("checksums diverged after resume: stdlib=%d adler32neon=%d", got, want)
+		}
+	})
+
+	t.Run("stdlib to adler32neon", func(t *testing.T) {
+		std := adler32.New()
+		std.Write([]byte(part1))
+
+		state, err := std.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary: %v", err)
+		}
+
+		d := New()
+		if err := d.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+			t.Fatalf("UnmarshalBinary: %v", err)
+		}
+
+		std.Write([]byte(part2))
+		d.Write([]byte(part2))
+
+		if got, want := d.Sum32(), std.Sum32(); got != want {
+			t.Fatalf("checksums diverged after resume: adler32neon=%d stdlib=%d", got, want)
+		}
+	})
+}
+
+func TestUnmarshalBinaryRejectsBadMagic(t *testing.T) {
+	d := New()
+	err := d.(encoding.BinaryUnmarshaler).UnmarshalBinary([]byte("bad!\x00\x00\x00\x01"))
+	if err == nil {
+		t.Fatal("expected an error for an invalid magic prefix, got nil")
+	}
+}
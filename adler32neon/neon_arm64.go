@@ -0,0 +1,123 @@
+//go:build arm64 && cgo
+
+package adler32neon
+
+/*
+#cgo CFLAGS: -I${SRCDIR}
+#cgo LDFLAGS: -L${SRCDIR} -ladler32_neon
+#include "adler32_neon.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"hash"
+	"unsafe"
+
+	"golang.org/x/sys/cpu"
+)
+
+// nmax is the largest n such that 255n(n+1)/2 + (n+1)(mod-1) <= 2^32-1.
+// Write splits its input into chunks of at most nmax bytes so the
+// partial s1/s2 sums can't overflow a uint32 before the C kernel
+// reduces them modulo mod.
+const nmax = 5552
+
+// magic is the marker byte sequence for marshaled digests, matching
+// the wire format used by the standard library's hash/adler32 so
+// that states can be checkpointed across the two implementations.
+const (
+	magic         = "adl\x01"
+	marshaledSize = len(magic) + 4
+)
+
+func init() {
+	// The NEON kernel assumes ASIMD is available; without it, fall back
+	// to the pure Go implementation rather than risk an illegal
+	// instruction.
+	if cpu.ARM64.HasASIMD {
+		backend = newNeonDigest
+	}
+}
+
+// digest represents the partial evaluation of an Adler-32 checksum,
+// computed via the NEON C kernel.
+type digest struct {
+	s1, s2 uint32
+}
+
+// newNeonDigest returns a new hash.Hash32 computing the Adler-32 checksum
+// using the NEON-accelerated C kernel. Its Sum method lays the value out
+// in big-endian byte order, matching hash/adler32.
+func newNeonDigest() hash.Hash32 {
+	d := new(digest)
+	d.Reset()
+	return d
+}
+
+func (d *digest) Reset() {
+	d.s1, d.s2 = 1, 0
+}
+
+func (d *digest) Size() int { return Size }
+
+func (d *digest) BlockSize() int { return 1 }
+
+func (d *digest) Write(p []byte) (n int, err error) {
+	n = len(p)
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > nmax {
+			chunk = chunk[:nmax]
+		}
+		d.update(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// update feeds a single chunk of at most nmax bytes to the C kernel,
+// advancing the running (s1, s2) state in place.
+func (d *digest) update(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+	cData := (*C.uint8_t)(unsafe.Pointer(&p[0]))
+	cLen := C.size_t(len(p))
+	state := C.adler32_neon_update(C.uint32_t(d.s1), C.uint32_t(d.s2), cData, cLen)
+	d.s1 = uint32(state.s1)
+	d.s2 = uint32(state.s2)
+}
+
+func (d *digest) Sum32() uint32 { return d.s2<<16 | d.s1 }
+
+func (d *digest) Sum(in []byte) []byte {
+	s := d.Sum32()
+	return append(in, byte(s>>24), byte(s>>16), byte(s>>8), byte(s))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It uses the same
+// wire format as hash/adler32: the 4-byte magic "adl\x01" followed by the
+// 4-byte big-endian digest value, so a partially-written digest can be
+// checkpointed and resumed by either implementation.
+func (d *digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	b = append(b, magic...)
+	s := d.Sum32()
+	b = append(b, byte(s>>24), byte(s>>16), byte(s>>8), byte(s))
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *digest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic) || string(b[:len(magic)]) != magic {
+		return errors.New("adler32neon: invalid hash state identifier")
+	}
+	if len(b) != marshaledSize {
+		return errors.New("adler32neon: invalid hash state size")
+	}
+	b = b[len(magic):]
+	s := uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	d.s1, d.s2 = s&0xffff, s>>16
+	return nil
+}
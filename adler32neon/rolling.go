@@ -0,0 +1,86 @@
+package adler32neon
+
+// Rolling computes an Adler-32 checksum over a sliding window of the
+// most recent bytes seen, suitable for rsync-style content-defined
+// chunking and delta-sync. Unlike digest, it is pure Go: rolling one
+// byte at a time through the NEON kernel would cost more in cgo call
+// overhead than the checksum update itself saves.
+type Rolling struct {
+	window int
+	buf    []byte
+	pos    int
+	filled bool
+	s1, s2 uint32
+}
+
+// NewRolling returns a Rolling checksum over a window of the given size.
+// window must be positive.
+func NewRolling(window int) *Rolling {
+	r := &Rolling{
+		window: window,
+		buf:    make([]byte, window),
+	}
+	r.Reset()
+	return r
+}
+
+// Reset clears the window and returns the Rolling checksum to its
+// initial state.
+func (r *Rolling) Reset() {
+	for i := range r.buf {
+		r.buf[i] = 0
+	}
+	r.pos = 0
+	r.filled = false
+	r.s1, r.s2 = 1, 0
+}
+
+// Roll feeds the next byte in and returns the updated checksum. Until
+// the window has filled for the first time, Roll behaves like a normal
+// incremental Adler-32 over the bytes seen so far; once full, the
+// oldest byte is subtracted out as it leaves the window.
+func (r *Rolling) Roll(in byte) uint32 {
+	if !r.filled {
+		r.s1 = (r.s1 + uint32(in)) % mod
+		r.s2 = (r.s2 + r.s1) % mod
+		r.buf[r.pos] = in
+		r.pos++
+		if r.pos == len(r.buf) {
+			r.pos = 0
+			r.filled = true
+		}
+		return r.Sum32()
+	}
+
+	old := r.buf[r.pos]
+	r.buf[r.pos] = in
+	r.pos++
+	if r.pos == len(r.buf) {
+		r.pos = 0
+	}
+
+	// Use int64 intermediates so the subtractions below can't underflow
+	// a uint32 before the final mod reduction.
+	w := int64(r.window)
+	c := int64(in)
+	o := int64(old)
+
+	s1 := (int64(r.s1) + c - o) % mod
+	if s1 < 0 {
+		s1 += mod
+	}
+	s2 := (int64(r.s2) + int64(s1) - w*o - 1) % mod
+	if s2 < 0 {
+		s2 += mod
+	}
+
+	r.s1, r.s2 = uint32(s1), uint32(s2)
+
+	return r.Sum32()
+}
+
+// Sum32 returns the Adler-32 checksum of the bytes currently in the
+// window without consuming any input.
+func (r *Rolling) Sum32() uint32 {
+	return r.s2<<16 | r.s1
+}
@@ -0,0 +1,42 @@
+package adler32neon
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestRollingMatchesBulk checks that, once the window has filled, Rolling's
+// checksum after each byte matches a fresh Adler-32 computed over the same
+// trailing window of bytes.
+func TestRollingMatchesBulk(t *testing.T) {
+	const window = 16
+	data := bytes.Repeat([]byte("abcdefghijklmnopqrstuvwxyz"), 10)
+
+	r := NewRolling(window)
+	for i, b := range data {
+		got := r.Roll(b)
+
+		if i+1 < window {
+			continue
+		}
+
+		h := New()
+		h.Write(data[i+1-window : i+1])
+		if want := h.Sum32(); got != want {
+			t.Fatalf("byte %d: Roll=%d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestRollingReset(t *testing.T) {
+	r := NewRolling(8)
+	for _, b := range []byte("some bytes to roll through") {
+		r.Roll(b)
+	}
+	r.Reset()
+
+	fresh := NewRolling(8)
+	if got, want := r.Sum32(), fresh.Sum32(); got != want {
+		t.Fatalf("after Reset: Sum32=%d, want %d", got, want)
+	}
+}